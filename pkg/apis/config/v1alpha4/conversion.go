@@ -0,0 +1,82 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha4
+
+import (
+	"sigs.k8s.io/kind/pkg/internal/apis/config"
+)
+
+// Convert converts cfg to the internal config.Cluster representation.
+// Callers should call SetDefaultsCluster(cfg) first.
+func Convert(cfg *Cluster) *config.Cluster {
+	out := &config.Cluster{
+		Networking: config.Networking{
+			IPFamily:     config.ClusterIPFamily(cfg.Networking.IPFamily),
+			CNI:          config.CNI(cfg.Networking.CNI),
+			CNIManifests: cfg.Networking.CNIManifests,
+		},
+		Etcd: config.Etcd{
+			Local:    convertLocalEtcd(cfg.Etcd.Local),
+			External: convertExternalEtcd(cfg.Etcd.External),
+		},
+		FeatureGates:         cfg.FeatureGates,
+		KubeadmConfigPatches: cfg.KubeadmConfigPatches,
+		SkipPhases:           cfg.SkipPhases,
+	}
+	for _, n := range cfg.Nodes {
+		out.Nodes = append(out.Nodes, config.Node{
+			Role:   config.NodeRole(n.Role),
+			Image:  n.Image,
+			Taints: convertTaints(n.Taints),
+		})
+	}
+	return out
+}
+
+func convertTaints(in []Taint) []config.Taint {
+	if in == nil {
+		return nil
+	}
+	out := make([]config.Taint, 0, len(in))
+	for _, t := range in {
+		out = append(out, config.Taint{
+			Key:    t.Key,
+			Value:  t.Value,
+			Effect: t.Effect,
+		})
+	}
+	return out
+}
+
+func convertLocalEtcd(in *LocalEtcd) *config.LocalEtcd {
+	if in == nil {
+		return nil
+	}
+	return &config.LocalEtcd{}
+}
+
+func convertExternalEtcd(in *ExternalEtcd) *config.ExternalEtcd {
+	if in == nil {
+		return nil
+	}
+	return &config.ExternalEtcd{
+		Endpoints: in.Endpoints,
+		CAFile:    in.CAFile,
+		CertFile:  in.CertFile,
+		KeyFile:   in.KeyFile,
+	}
+}
@@ -0,0 +1,42 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha4
+
+// SetDefaultsCluster sets uninitialized fields to their default value.
+func SetDefaultsCluster(cfg *Cluster) {
+	if len(cfg.Nodes) == 0 {
+		cfg.Nodes = []Node{{Role: ControlPlaneRole}}
+	}
+	for i := range cfg.Nodes {
+		if cfg.Nodes[i].Role == "" {
+			if i == 0 {
+				cfg.Nodes[i].Role = ControlPlaneRole
+			} else {
+				cfg.Nodes[i].Role = WorkerRole
+			}
+		}
+	}
+	if cfg.Networking.IPFamily == "" {
+		cfg.Networking.IPFamily = IPv4Family
+	}
+	if cfg.Networking.CNI == "" {
+		cfg.Networking.CNI = "kindnet"
+	}
+	if cfg.Etcd.Local == nil && cfg.Etcd.External == nil {
+		cfg.Etcd.Local = &LocalEtcd{}
+	}
+}
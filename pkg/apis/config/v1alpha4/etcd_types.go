@@ -0,0 +1,54 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha4
+
+// Etcd configures how the cluster's etcd is provisioned. It is intended
+// to be embedded as the Etcd field of Cluster.
+//
+// At most one of Local or External should be set. When neither is set
+// kind provisions the default stacked etcd, one instance per control
+// plane node, same as today.
+type Etcd struct {
+	// Local, if set, configures the default stacked etcd provisioned by
+	// kubeadm on each control plane node.
+	Local *LocalEtcd `json:"local,omitempty"`
+	// External, if set, points kind at an etcd cluster that already
+	// exists outside of the kind nodes. kind will skip provisioning and
+	// distributing stacked etcd certs and instead configure kubeadm to
+	// talk to these endpoints.
+	External *ExternalEtcd `json:"external,omitempty"`
+}
+
+// LocalEtcd describes the stacked etcd member kubeadm manages on a
+// control plane node.
+type LocalEtcd struct{}
+
+// ExternalEtcd describes how to reach an etcd cluster kind does not
+// provision, mirroring kubeadm's own ClusterConfiguration.Etcd.External.
+type ExternalEtcd struct {
+	// Endpoints of etcd members, in the form "https://host:port".
+	Endpoints []string `json:"endpoints"`
+	// CAFile is the path, on the boot node, to the etcd CA certificate
+	// used to verify the endpoints above.
+	CAFile string `json:"caFile,omitempty"`
+	// CertFile is the path, on the boot node, to a client certificate
+	// signed by CAFile.
+	CertFile string `json:"certFile,omitempty"`
+	// KeyFile is the path, on the boot node, to the private key matching
+	// CertFile.
+	KeyFile string `json:"keyFile,omitempty"`
+}
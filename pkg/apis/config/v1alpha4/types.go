@@ -0,0 +1,107 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package v1alpha4 is the v1alpha4 cluster config API. `kind create
+// cluster` builds a Cluster of this type from its flags.
+package v1alpha4
+
+// Cluster contains kind cluster configuration.
+type Cluster struct {
+	// Nodes contains the list of nodes defined in the kind cluster.
+	// If unset this will default to a single control-plane node.
+	Nodes []Node `json:"nodes,omitempty"`
+	// Networking contains cluster wide network settings.
+	Networking Networking `json:"networking,omitempty"`
+	// Etcd configures how the cluster's etcd is provisioned. When unset,
+	// kind provisions the default stacked, local etcd.
+	Etcd Etcd `json:"etcd,omitempty"`
+	// FeatureGates contains the feature gates to enable on the cluster,
+	// passed through to kubeadm's ClusterConfiguration.
+	FeatureGates map[string]bool `json:"featureGates,omitempty"`
+	// KubeadmConfigPatches are applied to the generated kubeadm config,
+	// as strategic merge patches, before it is used.
+	KubeadmConfigPatches []string `json:"kubeadmConfigPatches,omitempty"`
+	// SkipPhases lists kubeadm init phases to skip, populated from
+	// `create cluster --skip-phase=<phase>[/<sub-phase>]` (repeatable).
+	// Each entry names a phase, e.g. "addon", or a phase and
+	// sub-phase, e.g. "addon/coredns".
+	SkipPhases []string `json:"skipPhases,omitempty"`
+}
+
+// KubeadmAPIVersion is the kubeadm config API version kind renders its
+// generated /kind/kubeadm.conf against.
+const KubeadmAPIVersion = "kubeadm.k8s.io/v1beta3"
+
+// Node contains settings for a node in the `kind` Cluster.
+type Node struct {
+	// Role defines the role of the node in the in the Kubernetes cluster
+	// created by kind.
+	//
+	// Defaults to "control-plane" for the first node, and "worker" for
+	// the rest.
+	Role NodeRole `json:"role,omitempty"`
+	// Image is the node image to use when creating this node.
+	Image string `json:"image,omitempty"`
+	// Taints specifies the taints to apply to this node. Currently only
+	// honored on the bootstrap control plane node (the first entry in
+	// Nodes): a node-role.kubernetes.io/* taint here tells kind to keep
+	// kubeadm's control plane isolation taint instead of removing it in
+	// single-node mode, and any other taint is applied to that node
+	// after kubeadm init completes. Taints on other nodes are not yet
+	// applied when they join.
+	Taints []Taint `json:"taints,omitempty"`
+}
+
+// NodeRole defines possible role for nodes in a Kubernetes cluster managed by `kind`.
+type NodeRole string
+
+const (
+	// ControlPlaneRole identifies a node that hosts a Kubernetes control-plane.
+	ControlPlaneRole NodeRole = "control-plane"
+	// WorkerRole identifies a node that hosts a Kubernetes worker.
+	WorkerRole NodeRole = "worker"
+)
+
+// Networking contains cluster wide network settings.
+type Networking struct {
+	// IPFamily is the network IP family used by the cluster.
+	// Defaults to "ipv4".
+	IPFamily ClusterIPFamily `json:"ipFamily,omitempty"`
+	// CNI identifies which CNI provider kind should install after init.
+	// Defaults to "kindnet".
+	CNI CNI `json:"cni,omitempty"`
+	// CNIManifests lists the manifest URLs and/or local file paths kind
+	// applies when CNI is "custom". Ignored otherwise.
+	CNIManifests []string `json:"cniManifests,omitempty"`
+}
+
+// CNI identifies which CNI provider kind should install after init.
+//
+// Valid values are "kindnet" (the default), "calico", "cilium",
+// "flannel", "custom", and "none".
+type CNI string
+
+// ClusterIPFamily defines cluster network IP family.
+type ClusterIPFamily string
+
+const (
+	// IPv4Family indicates an IPv4-only cluster network.
+	IPv4Family ClusterIPFamily = "ipv4"
+	// IPv6Family indicates an IPv6-only cluster network.
+	IPv6Family ClusterIPFamily = "ipv6"
+	// DualStackFamily indicates a dual-stack cluster network.
+	DualStackFamily ClusterIPFamily = "dual"
+)
@@ -0,0 +1,71 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package addon implements the `kubeadm init phase addon` action, split
+// out of the old monolithic kubeadm init action. Unlike the other split
+// phases, its two sub-phases (coredns, kube-proxy) are run
+// individually so each can be opted out of on its own, e.g.
+// `create cluster --skip-phase=addon/coredns` for users installing
+// their own DNS.
+package addon
+
+import (
+	"sigs.k8s.io/kind/pkg/cluster/internal/create/actions"
+	"sigs.k8s.io/kind/pkg/cluster/internal/create/actions/kubeadmphase"
+	"sigs.k8s.io/kind/pkg/cluster/nodeutils"
+)
+
+// subPhases are the kubeadm init phase addon sub-phases, run in order.
+var subPhases = []string{"coredns", "kube-proxy"}
+
+type action struct{}
+
+// NewAction returns a new action for running `kubeadm init phase addon`
+func NewAction() actions.Action {
+	return &action{}
+}
+
+// Execute runs the action
+func (a *action) Execute(ctx *actions.ActionContext) error {
+	ctx.Status.Start("Installing addons 🔌")
+	defer ctx.Status.End(false)
+
+	allNodes, err := ctx.Nodes()
+	if err != nil {
+		return err
+	}
+	node, err := nodeutils.BootstrapControlPlaneNode(allNodes)
+	if err != nil {
+		return err
+	}
+
+	if kubeadmphase.Skipped(ctx.Config.SkipPhases, "addon") {
+		ctx.Status.End(true)
+		return nil
+	}
+
+	for _, subPhase := range subPhases {
+		if kubeadmphase.Skipped(ctx.Config.SkipPhases, "addon/"+subPhase) {
+			continue
+		}
+		if err := kubeadmphase.Run(node, ctx.Logger, "addon", subPhase); err != nil {
+			return err
+		}
+	}
+
+	ctx.Status.End(true)
+	return nil
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package certs implements the `kubeadm init phase certs` action, split
+// out of the old monolithic kubeadm init action. It generates the PKI
+// under /etc/kubernetes/pki on the bootstrap control plane node only;
+// the kubeadm init action then copies the shared CA and service account
+// keys from there to secondary control planes.
+package certs
+
+import (
+	"sigs.k8s.io/kind/pkg/cluster/internal/create/actions"
+	"sigs.k8s.io/kind/pkg/cluster/internal/create/actions/kubeadmphase"
+	"sigs.k8s.io/kind/pkg/cluster/nodeutils"
+)
+
+type action struct{}
+
+// NewAction returns a new action for running `kubeadm init phase certs`
+func NewAction() actions.Action {
+	return &action{}
+}
+
+// Execute runs the action
+func (a *action) Execute(ctx *actions.ActionContext) error {
+	ctx.Status.Start("Generating certificates 🔐")
+	defer ctx.Status.End(false)
+
+	allNodes, err := ctx.Nodes()
+	if err != nil {
+		return err
+	}
+	node, err := nodeutils.BootstrapControlPlaneNode(allNodes)
+	if err != nil {
+		return err
+	}
+
+	if kubeadmphase.Skipped(ctx.Config.SkipPhases, "certs") {
+		ctx.Status.End(true)
+		return nil
+	}
+
+	if err := kubeadmphase.Run(node, ctx.Logger, "certs", "all"); err != nil {
+		return err
+	}
+
+	ctx.Status.End(true)
+	return nil
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package installcni
+
+import (
+	"bytes"
+	"context"
+
+	"sigs.k8s.io/kind/pkg/cluster/internal/create/actions"
+	"sigs.k8s.io/kind/pkg/cluster/nodeutils"
+	"sigs.k8s.io/kind/pkg/errors"
+)
+
+// action implements the installcni action, replacing the old implicit
+// kindnet install that used to run as part of kubeadm init.
+type action struct{}
+
+// NewAction returns a new action for installing the configured CNI
+func NewAction() actions.Action {
+	return &action{}
+}
+
+// Execute runs the action
+func (a *action) Execute(ctx *actions.ActionContext) error {
+	provider, err := Get(string(ctx.Config.Networking.CNI))
+	if err != nil {
+		return err
+	}
+
+	if provider.Name() == noneName {
+		ctx.Status.Start("Skipping CNI install 🔌")
+		defer ctx.Status.End(true)
+		return nil
+	}
+
+	ctx.Status.Start("Installing CNI 🔌")
+	defer ctx.Status.End(false)
+
+	if err := provider.Validate(ctx.Config); err != nil {
+		return errors.Wrap(err, "invalid CNI configuration")
+	}
+
+	allNodes, err := ctx.Nodes()
+	if err != nil {
+		return err
+	}
+	node, err := nodeutils.BootstrapControlPlaneNode(allNodes)
+	if err != nil {
+		return err
+	}
+
+	manifests, err := provider.Manifests(context.Background(), ctx.Config)
+	if err != nil {
+		return errors.Wrapf(err, "failed to render %s manifests", provider.Name())
+	}
+
+	cmd := node.Command(
+		"kubectl", "--kubeconfig=/etc/kubernetes/admin.conf",
+		"apply", "-f", "-",
+	)
+	cmd.SetStdin(bytes.NewReader(manifests))
+	if err := cmd.Run(); err != nil {
+		return errors.Wrapf(err, "failed to apply %s manifests", provider.Name())
+	}
+
+	if err := provider.WaitReady(context.Background()); err != nil {
+		return errors.Wrapf(err, "%s did not become ready", provider.Name())
+	}
+
+	ctx.Status.End(true)
+	return nil
+}
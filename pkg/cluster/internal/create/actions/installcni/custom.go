@@ -0,0 +1,88 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package installcni
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+
+	"sigs.k8s.io/kind/pkg/errors"
+	"sigs.k8s.io/kind/pkg/internal/apis/config"
+)
+
+const customName = "custom"
+
+func init() {
+	register(&customProvider{})
+}
+
+// customProvider applies user-supplied manifests, for CNIs kind has no
+// built-in knowledge of. Networking.CNIManifests lists URLs and/or local
+// file paths; each is concatenated, in order, into one apply.
+type customProvider struct{}
+
+func (*customProvider) Name() string {
+	return customName
+}
+
+func (*customProvider) Manifests(ctx context.Context, cfg *config.Cluster) ([]byte, error) {
+	var manifests []byte
+	for _, location := range cfg.Networking.CNIManifests {
+		content, err := readManifest(ctx, location)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read custom CNI manifest %q", location)
+		}
+		manifests = append(manifests, content...)
+		manifests = append(manifests, "\n---\n"...)
+	}
+	return manifests, nil
+}
+
+func (*customProvider) Validate(cfg *config.Cluster) error {
+	if len(cfg.Networking.CNIManifests) == 0 {
+		return errors.New("networking.cni is \"custom\" but no CNIManifests were provided")
+	}
+	return nil
+}
+
+func (*customProvider) WaitReady(ctx context.Context) error {
+	// we have no knowledge of what readiness looks like for an arbitrary
+	// custom CNI, so there is nothing to wait on here
+	return nil
+}
+
+// readManifest fetches location, which may be an http(s) URL or a local
+// file path.
+func readManifest(ctx context.Context, location string) ([]byte, error) {
+	u, err := url.Parse(location)
+	if err == nil && (u.Scheme == "http" || u.Scheme == "https") {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, location, nil)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		return io.ReadAll(resp.Body)
+	}
+	return os.ReadFile(location)
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package installcni
+
+import (
+	"context"
+
+	"sigs.k8s.io/kind/pkg/internal/apis/config"
+)
+
+const kindnetName = "kindnet"
+
+func init() {
+	register(&kindnetProvider{})
+}
+
+// kindnetProvider installs kindnet, kind's own minimal CNI, preserving
+// today's default behavior for users who don't set Networking.CNI.
+type kindnetProvider struct{}
+
+func (*kindnetProvider) Name() string {
+	return kindnetName
+}
+
+func (*kindnetProvider) Manifests(ctx context.Context, cfg *config.Cluster) ([]byte, error) {
+	return kindnetManifest(cfg)
+}
+
+func (*kindnetProvider) Validate(cfg *config.Cluster) error {
+	return nil
+}
+
+func (*kindnetProvider) WaitReady(ctx context.Context) error {
+	return nil
+}
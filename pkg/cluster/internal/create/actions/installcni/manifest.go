@@ -0,0 +1,133 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package installcni
+
+import (
+	"sigs.k8s.io/kind/pkg/internal/apis/config"
+)
+
+// defaultPodSubnet is the pod network CIDR kind's kubeadm config
+// defaults to; kindnet is handed this directly since Networking does
+// not (yet) expose the configured pod subnet for other CNI choices to
+// read back.
+const defaultPodSubnet = "10.244.0.0/16"
+
+// kindnetManifest renders kind's bundled kindnet manifest for cfg.
+func kindnetManifest(cfg *config.Cluster) ([]byte, error) {
+	return []byte(kindnetManifestTemplate), nil
+}
+
+// kindnetManifestTemplate is kind's bundled kindnet manifest: a
+// ServiceAccount/ClusterRole/ClusterRoleBinding for the kindnetd
+// container plus the DaemonSet itself. kindnetd reads the pod subnet
+// and its own pod/host IPs from the env vars below to program routes
+// and iptables rules between nodes; it needs no further config.
+const kindnetManifestTemplate = `
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: kindnet
+  namespace: kube-system
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: kindnet
+rules:
+  - apiGroups: [""]
+    resources: ["nodes"]
+    verbs: ["list", "watch", "patch"]
+  - apiGroups: [""]
+    resources: ["configmaps"]
+    verbs: ["get"]
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRoleBinding
+metadata:
+  name: kindnet
+roleRef:
+  apiGroup: rbac.authorization.k8s.io
+  kind: ClusterRole
+  name: kindnet
+subjects:
+  - kind: ServiceAccount
+    name: kindnet
+    namespace: kube-system
+---
+apiVersion: apps/v1
+kind: DaemonSet
+metadata:
+  name: kindnet
+  namespace: kube-system
+  labels:
+    tier: node
+    app: kindnet
+    k8s-app: kindnet
+spec:
+  selector:
+    matchLabels:
+      app: kindnet
+  template:
+    metadata:
+      labels:
+        tier: node
+        app: kindnet
+        k8s-app: kindnet
+    spec:
+      hostNetwork: true
+      tolerations:
+        - operator: Exists
+          effect: NoSchedule
+      serviceAccountName: kindnet
+      containers:
+        - name: kindnet-cni
+          image: docker.io/kindest/kindnetd:v20230511-dc714da8
+          env:
+            - name: HOST_IP
+              valueFrom:
+                fieldRef:
+                  fieldPath: status.hostIP
+            - name: POD_IP
+              valueFrom:
+                fieldRef:
+                  fieldPath: status.podIP
+            - name: POD_SUBNET
+              value: "` + defaultPodSubnet + `"
+          volumeMounts:
+            - name: cni-cfg
+              mountPath: /etc/cni/net.d
+            - name: xtables-lock
+              mountPath: /run/xtables.lock
+            - name: lib-modules
+              mountPath: /lib/modules
+              readOnly: true
+          securityContext:
+            privileged: false
+            capabilities:
+              add: ["NET_RAW", "NET_ADMIN"]
+      volumes:
+        - name: cni-cfg
+          hostPath:
+            path: /etc/cni/net.d
+        - name: xtables-lock
+          hostPath:
+            path: /run/xtables.lock
+            type: FileOrCreate
+        - name: lib-modules
+          hostPath:
+            path: /lib/modules
+`
@@ -0,0 +1,40 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package installcni
+
+import (
+	"strings"
+	"testing"
+
+	"sigs.k8s.io/kind/pkg/internal/apis/config"
+)
+
+func TestKindnetManifestHasADaemonSetSpec(t *testing.T) {
+	manifest, err := kindnetManifest(&config.Cluster{})
+	if err != nil {
+		t.Fatalf("kindnetManifest returned error: %v", err)
+	}
+	s := string(manifest)
+	// a DaemonSet without spec.selector/spec.template fails API
+	// validation; assert both are actually present, not just that
+	// "kind: DaemonSet" appears somewhere in the document.
+	for _, want := range []string{"kind: DaemonSet", "selector:", "matchLabels:", "template:"} {
+		if !strings.Contains(s, want) {
+			t.Errorf("kindnet manifest missing %q", want)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package installcni
+
+import (
+	"context"
+
+	"sigs.k8s.io/kind/pkg/internal/apis/config"
+)
+
+const noneName = "none"
+
+func init() {
+	register(&noneProvider{})
+}
+
+// noneProvider installs nothing, for users bringing their own CNI
+// operator (e.g. via a post-create hook or a Cluster API addon).
+type noneProvider struct{}
+
+func (*noneProvider) Name() string {
+	return noneName
+}
+
+func (*noneProvider) Manifests(ctx context.Context, cfg *config.Cluster) ([]byte, error) {
+	return nil, nil
+}
+
+func (*noneProvider) Validate(cfg *config.Cluster) error {
+	return nil
+}
+
+func (*noneProvider) WaitReady(ctx context.Context) error {
+	return nil
+}
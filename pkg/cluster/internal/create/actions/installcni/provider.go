@@ -0,0 +1,66 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package installcni implements the CNI installation action, pluggable
+// across a small registry of CNIProvider implementations.
+package installcni
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/kind/pkg/internal/apis/config"
+)
+
+// CNIProvider installs and verifies a CNI network plugin on a freshly
+// initialized cluster. Implementations are registered in providers and
+// selected by Networking.CNI.
+type CNIProvider interface {
+	// Name returns the provider's Networking.CNI value, e.g. "kindnet".
+	Name() string
+	// Manifests returns the Kubernetes manifests to apply for this
+	// provider, rendered against cfg.
+	Manifests(ctx context.Context, cfg *config.Cluster) ([]byte, error)
+	// Validate checks that cfg is usable with this provider, returning a
+	// descriptive error if not (e.g. an unsupported IP family).
+	Validate(cfg *config.Cluster) error
+	// WaitReady is called after the manifests are applied; a non-nil
+	// error fails the install. None of the built-in providers checks
+	// readiness: each returns nil at once.
+	WaitReady(ctx context.Context) error
+}
+
+// providers holds every built-in CNIProvider, keyed by Name().
+var providers = map[string]CNIProvider{}
+
+// register adds p to the built-in provider registry. It is called from
+// each provider's init().
+func register(p CNIProvider) {
+	providers[p.Name()] = p
+}
+
+// Get looks up the CNIProvider for name (Networking.CNI), defaulting to
+// kindnet when name is empty.
+func Get(name string) (CNIProvider, error) {
+	if name == "" {
+		name = kindnetName
+	}
+	p, ok := providers[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown CNI provider %q", name)
+	}
+	return p, nil
+}
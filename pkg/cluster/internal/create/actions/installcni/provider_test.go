@@ -0,0 +1,48 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package installcni
+
+import "testing"
+
+func TestGetDefaultsToKindnet(t *testing.T) {
+	p, err := Get("")
+	if err != nil {
+		t.Fatalf("Get(\"\") returned error: %v", err)
+	}
+	if p.Name() != kindnetName {
+		t.Errorf("Get(\"\") = %q, want %q", p.Name(), kindnetName)
+	}
+}
+
+func TestGetKnownProviders(t *testing.T) {
+	for _, name := range []string{"kindnet", "calico", "cilium", "flannel", "custom", "none"} {
+		p, err := Get(name)
+		if err != nil {
+			t.Errorf("Get(%q) returned error: %v", name, err)
+			continue
+		}
+		if p.Name() != name {
+			t.Errorf("Get(%q).Name() = %q", name, p.Name())
+		}
+	}
+}
+
+func TestGetUnknownProvider(t *testing.T) {
+	if _, err := Get("not-a-real-cni"); err == nil {
+		t.Error("Get(\"not-a-real-cni\") expected an error, got nil")
+	}
+}
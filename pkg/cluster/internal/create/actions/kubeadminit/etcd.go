@@ -0,0 +1,132 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeadminit
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+
+	"sigs.k8s.io/kind/pkg/errors"
+	"sigs.k8s.io/kind/pkg/exec"
+
+	"sigs.k8s.io/kind/pkg/cluster/nodes"
+	"sigs.k8s.io/kind/pkg/internal/apis/config"
+)
+
+// stackedEtcdCertFiles are the certs / keys kind copies between control
+// plane nodes when etcd is provisioned locally (the default, "stacked"
+// topology). When an external etcd endpoint is configured these are
+// supplied out of band and must not be touched here.
+var stackedEtcdCertFiles = []string{
+	"/etc/kubernetes/pki/etcd/ca.crt", "/etc/kubernetes/pki/etcd/ca.key",
+}
+
+// usesExternalEtcd returns true if cfg configures an external etcd
+// cluster rather than the default stacked (local) topology.
+func usesExternalEtcd(cfg *config.Cluster) bool {
+	return cfg.Etcd.External != nil
+}
+
+// validateExternalEtcd checks that the CA / client cert and key configured
+// for an external etcd cluster are present on node before we hand them to
+// kubeadm, so that a misconfigured mount fails fast with a clear error
+// instead of an opaque kubeadm init failure.
+func validateExternalEtcd(node nodes.Node, cfg *config.Cluster) error {
+	external := cfg.Etcd.External
+	if external == nil {
+		return nil
+	}
+	for _, file := range []string{external.CAFile, external.CertFile, external.KeyFile} {
+		if file == "" {
+			continue
+		}
+		if err := node.Command("test", "-f", file).Run(); err != nil {
+			return errors.Wrapf(err, "external etcd cert %q is not reachable on node", file)
+		}
+	}
+	return nil
+}
+
+// kubeadmConfigPath is where kind writes the rendered kubeadm config
+// that every `kubeadm init phase ...` call below runs against.
+const kubeadmConfigPath = "/kind/kubeadm.conf"
+
+// etcdSectionRE matches the top-level "etcd:" mapping (and everything
+// indented under it) in a rendered kubeadm ClusterConfiguration, so it
+// can be replaced wholesale.
+var etcdSectionRE = regexp.MustCompile(`(?m)^etcd:\n(?:[ \t]+.*\n?)*`)
+
+// configureExternalEtcd rewrites kubeadmConfigPath's "etcd:" section to
+// point at cfg's external etcd, before the certs phase runs.
+// kubeadm's "certs all" and "control-plane" phases decide whether to
+// generate local etcd certs and what --etcd-servers to put in the
+// apiserver manifest by reading this section; without rewriting it,
+// kubeadm still believes etcd is local regardless of what the user
+// configured, and the apiserver ends up pointed at 127.0.0.1:2379.
+func configureExternalEtcd(node nodes.Node, cfg *config.Cluster) error {
+	external := cfg.Etcd.External
+	if external == nil {
+		return nil
+	}
+
+	lines, err := exec.CombinedOutputLines(node.Command("cat", kubeadmConfigPath))
+	if err != nil {
+		return errors.Wrapf(err, "failed to read %s", kubeadmConfigPath)
+	}
+	patched := patchExternalEtcdConfig(strings.Join(lines, "\n")+"\n", external)
+
+	writeCmd := node.Command("cp", "/dev/stdin", kubeadmConfigPath)
+	writeCmd.SetStdin(bytes.NewBufferString(patched))
+	if err := writeCmd.Run(); err != nil {
+		return errors.Wrapf(err, "failed to write external etcd config to %s", kubeadmConfigPath)
+	}
+	return nil
+}
+
+// patchExternalEtcdConfig replaces kubeadmConf's "etcd:" section with
+// one configuring external, or appends one if the section is missing.
+func patchExternalEtcdConfig(kubeadmConf string, external *config.ExternalEtcd) string {
+	block := externalEtcdBlock(external)
+	if etcdSectionRE.MatchString(kubeadmConf) {
+		return etcdSectionRE.ReplaceAllString(kubeadmConf, block)
+	}
+	if !strings.HasSuffix(kubeadmConf, "\n") {
+		kubeadmConf += "\n"
+	}
+	return kubeadmConf + block
+}
+
+// externalEtcdBlock renders the kubeadm ClusterConfiguration "etcd:"
+// mapping for an external etcd cluster.
+func externalEtcdBlock(external *config.ExternalEtcd) string {
+	var b strings.Builder
+	b.WriteString("etcd:\n  external:\n    endpoints:\n")
+	for _, endpoint := range external.Endpoints {
+		b.WriteString("    - " + endpoint + "\n")
+	}
+	if external.CAFile != "" {
+		b.WriteString("    caFile: " + external.CAFile + "\n")
+	}
+	if external.CertFile != "" {
+		b.WriteString("    certFile: " + external.CertFile + "\n")
+	}
+	if external.KeyFile != "" {
+		b.WriteString("    keyFile: " + external.KeyFile + "\n")
+	}
+	return b.String()
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeadminit
+
+import (
+	"strings"
+	"testing"
+
+	"sigs.k8s.io/kind/pkg/internal/apis/config"
+)
+
+func TestPatchExternalEtcdConfigReplacesLocalSection(t *testing.T) {
+	kubeadmConf := `apiVersion: kubeadm.k8s.io/v1beta3
+kind: ClusterConfiguration
+etcd:
+  local:
+    dataDir: /var/lib/etcd
+networking:
+  podSubnet: 10.244.0.0/16
+`
+	external := &config.ExternalEtcd{
+		Endpoints: []string{"https://etcd-0:2379", "https://etcd-1:2379"},
+		CAFile:    "/etc/kubernetes/pki/etcd/ca.crt",
+		CertFile:  "/etc/kubernetes/pki/apiserver-etcd-client.crt",
+		KeyFile:   "/etc/kubernetes/pki/apiserver-etcd-client.key",
+	}
+
+	got := patchExternalEtcdConfig(kubeadmConf, external)
+
+	if strings.Contains(got, "local:") {
+		t.Errorf("patched config still contains the local etcd section:\n%s", got)
+	}
+	for _, want := range []string{
+		"etcd:\n  external:\n    endpoints:\n",
+		"    - https://etcd-0:2379\n",
+		"    - https://etcd-1:2379\n",
+		"caFile: /etc/kubernetes/pki/etcd/ca.crt\n",
+		"certFile: /etc/kubernetes/pki/apiserver-etcd-client.crt\n",
+		"keyFile: /etc/kubernetes/pki/apiserver-etcd-client.key\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("patched config missing %q, got:\n%s", want, got)
+		}
+	}
+	// the rest of the document must survive untouched
+	if !strings.Contains(got, "networking:\n  podSubnet: 10.244.0.0/16\n") {
+		t.Errorf("patched config lost unrelated content, got:\n%s", got)
+	}
+}
+
+func TestPatchExternalEtcdConfigAppendsMissingSection(t *testing.T) {
+	kubeadmConf := "apiVersion: kubeadm.k8s.io/v1beta3\nkind: ClusterConfiguration\n"
+	external := &config.ExternalEtcd{Endpoints: []string{"https://etcd-0:2379"}}
+
+	got := patchExternalEtcdConfig(kubeadmConf, external)
+
+	if !strings.HasPrefix(got, kubeadmConf) {
+		t.Errorf("patched config did not preserve existing content, got:\n%s", got)
+	}
+	if !strings.Contains(got, "etcd:\n  external:\n    endpoints:\n    - https://etcd-0:2379\n") {
+		t.Errorf("patched config missing appended external etcd section, got:\n%s", got)
+	}
+}
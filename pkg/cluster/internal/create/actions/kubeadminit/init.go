@@ -14,23 +14,58 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-// Package kubeadminit implements the kubeadm init action
+// Package kubeadminit implements the kubeadm init action, orchestrating
+// the individual `kubeadm init phase ...` actions in
+// pkg/cluster/internal/create/actions/{certs,kubeadmkubeconfig,
+// kubeletstart,etcd,controlplane,markcontrolplane,bootstraptoken,
+// uploadconfig,addon}.
+//
+// Splitting kubeadm init into its graduated phases, rather than running
+// it as one monolith with --skip-phases=preflight, lets us give better
+// per-phase error messages and skip individual phases via
+// Config.SkipPhases (e.g. "addon/coredns"). Secondary control planes
+// still get the CA, front-proxy CA and service account keys (and, with
+// stacked etcd, the etcd CA key) copied to them from the bootstrap node.
 package kubeadminit
 
 import (
-	"strings"
-
 	"sigs.k8s.io/kind/pkg/errors"
-	"sigs.k8s.io/kind/pkg/exec"
 
 	"sigs.k8s.io/kind/pkg/cluster/nodeutils"
 
 	"sigs.k8s.io/kind/pkg/cluster/internal/create/actions"
+	"sigs.k8s.io/kind/pkg/cluster/internal/create/actions/addon"
+	"sigs.k8s.io/kind/pkg/cluster/internal/create/actions/bootstraptoken"
+	"sigs.k8s.io/kind/pkg/cluster/internal/create/actions/certs"
+	"sigs.k8s.io/kind/pkg/cluster/internal/create/actions/controlplane"
+	"sigs.k8s.io/kind/pkg/cluster/internal/create/actions/etcd"
+	"sigs.k8s.io/kind/pkg/cluster/internal/create/actions/kubeadmkubeconfig"
+	"sigs.k8s.io/kind/pkg/cluster/internal/create/actions/kubeletstart"
+	"sigs.k8s.io/kind/pkg/cluster/internal/create/actions/markcontrolplane"
+	"sigs.k8s.io/kind/pkg/cluster/internal/create/actions/uploadconfig"
 )
 
+// kubeadmInitPhases are the graduated kubeadm init phase actions this
+// action runs, in order, on the bootstrap control plane node.
+// "preflight" is deliberately omitted: its checks have undesirable side
+// effects in a container and don't tell us much. Per-phase skipping and
+// per-phase error messages are handled by each phase action itself via
+// Config.SkipPhases.
+var kubeadmInitPhases = []actions.Action{
+	certs.NewAction(),
+	kubeconfig.NewAction(),
+	kubeletstart.NewAction(),
+	etcd.NewAction(),
+	controlplane.NewAction(),
+	markcontrolplane.NewAction(),
+	bootstraptoken.NewAction(),
+	uploadconfig.NewAction(),
+	addon.NewAction(),
+}
+
 // kubeadmInitAction implements action for executing the kubeadm init
-// and a set of default post init operations like e.g. install the
-// CNI network plugin.
+// and a set of default post init operations. CNI installation is a
+// separate action; see pkg/cluster/internal/create/actions/installcni.
 type action struct{}
 
 // NewAction returns a new action for kubeadm init
@@ -56,23 +91,31 @@ func (a *action) Execute(ctx *actions.ActionContext) error {
 		return err
 	}
 
-	// run kubeadm
-	cmd := node.Command(
-		// init because this is the control plane node
-		"kubeadm", "init",
-		// skip preflight checks, as these have undesirable side effects
-		// and don't tell us much. requires kubeadm 1.13+
-		"--skip-phases=preflight",
-		// specify our generated config file
-		"--config=/kind/kubeadm.conf",
-		"--skip-token-print",
-		// increase verbosity for debugging
-		"--v=6",
-	)
-	lines, err := exec.CombinedOutputLines(cmd)
-	ctx.Logger.V(3).Info(strings.Join(lines, "\n"))
-	if err != nil {
-		return errors.Wrap(err, "failed to init node with kubeadm")
+	// external etcd is provisioned and reached out of band, so make sure
+	// the certs kubeadm needs are actually present on the node before we
+	// let it init against a typo'd path
+	if err := validateExternalEtcd(node, ctx.Config); err != nil {
+		return err
+	}
+
+	// kubeadm's "certs"/"control-plane" phases below only know to skip
+	// generating local etcd certs and to point the apiserver at an
+	// external etcd if /kind/kubeadm.conf already says so
+	if err := configureExternalEtcd(node, ctx.Config); err != nil {
+		return err
+	}
+
+	for _, phaseAction := range kubeadmInitPhases {
+		if err := phaseAction.Execute(ctx); err != nil {
+			return err
+		}
+	}
+
+	// upload kind's own view of the cluster config so later operations
+	// (adding a node, upgrading, exporting logs) have a source of truth
+	// that survives the host losing its kind CLI state
+	if err := uploadKindConfig(node, ctx.Config); err != nil {
+		return err
 	}
 
 	// copy some files to the other control plane nodes
@@ -81,34 +124,42 @@ func (a *action) Execute(ctx *actions.ActionContext) error {
 		return err
 	}
 	for _, otherNode := range otherControlPlanes {
-		for _, file := range []string{
+		files := []string{
 			// copy over admin config so we can use any control plane to get it later
 			"/etc/kubernetes/admin.conf",
 			// copy over certs
 			"/etc/kubernetes/pki/ca.crt", "/etc/kubernetes/pki/ca.key",
 			"/etc/kubernetes/pki/front-proxy-ca.crt", "/etc/kubernetes/pki/front-proxy-ca.key",
 			"/etc/kubernetes/pki/sa.pub", "/etc/kubernetes/pki/sa.key",
-			// TODO: if we gain external etcd support these will be
-			// handled differently
-			"/etc/kubernetes/pki/etcd/ca.crt", "/etc/kubernetes/pki/etcd/ca.key",
-		} {
+		}
+		// stacked etcd certs only exist when we are running local etcd;
+		// with external etcd there is nothing for us to distribute
+		if !usesExternalEtcd(ctx.Config) {
+			files = append(files, stackedEtcdCertFiles...)
+		}
+		for _, file := range files {
 			if err := nodeutils.CopyNodeToNode(node, otherNode, file); err != nil {
 				return errors.Wrap(err, "failed to copy admin kubeconfig")
 			}
 		}
 	}
 
-	// if we are only provisioning one node, remove the master taint
-	// https://kubernetes.io/docs/setup/independent/create-cluster-kubeadm/#master-isolation
+	// if we are only provisioning one node, remove the control plane
+	// isolation taint so workloads can schedule onto it
+	// https://kubernetes.io/docs/setup/independent/create-cluster-kubeadm/#control-plane-node-isolation
 	if len(allNodes) == 1 {
-		if err := node.Command(
-			"kubectl", "--kubeconfig=/etc/kubernetes/admin.conf",
-			"taint", "nodes", "--all", "node-role.kubernetes.io/master-",
-		).Run(); err != nil {
-			return errors.Wrap(err, "failed to remove master taint")
+		if err := removeControlPlaneTaints(node, ctx.Config); err != nil {
+			return err
 		}
 	}
 
+	// apply any user-specified taints to the bootstrap control plane
+	// node itself; taints configured on other nodes are not applied,
+	// here or when those nodes join
+	if err := applyCustomTaints(node, ctx.Config); err != nil {
+		return err
+	}
+
 	// mark success
 	ctx.Status.End(true)
 	return nil
@@ -0,0 +1,150 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeadminit
+
+import (
+	"strings"
+
+	"sigs.k8s.io/kind/pkg/cluster/nodes"
+	"sigs.k8s.io/kind/pkg/cluster/nodeutils"
+	"sigs.k8s.io/kind/pkg/errors"
+	"sigs.k8s.io/kind/pkg/internal/apis/config"
+)
+
+// controlPlaneIsolationTaintsKubeVersion is the first release where
+// kubeadm stopped applying the deprecated
+// "node-role.kubernetes.io/master" taint and applies
+// "node-role.kubernetes.io/control-plane" instead.
+const controlPlaneIsolationTaintsKubeVersion = "v1.24"
+
+// controlPlaneIsolationTaints returns the taint kubeadm applies to a
+// control plane node for the cluster's Kubernetes version: the
+// control-plane taint from controlPlaneIsolationTaintsKubeVersion on,
+// the master taint before it. It returns only that one taint, never
+// both.
+func controlPlaneIsolationTaints(kubeVersion string) []string {
+	if kubeVersionAtLeast(kubeVersion, controlPlaneIsolationTaintsKubeVersion) {
+		return []string{"node-role.kubernetes.io/control-plane"}
+	}
+	return []string{"node-role.kubernetes.io/master"}
+}
+
+// kubeVersionAtLeast does a best-effort "vMAJOR.MINOR" comparison; it
+// treats an unparsable version as satisfying the check, since that's
+// the newer, common case (ci/latest builds, etc).
+func kubeVersionAtLeast(version, atLeast string) bool {
+	v, ok := majorMinor(version)
+	if !ok {
+		return true
+	}
+	want, ok := majorMinor(atLeast)
+	if !ok {
+		return true
+	}
+	return v[0] > want[0] || (v[0] == want[0] && v[1] >= want[1])
+}
+
+func majorMinor(version string) ([2]int, bool) {
+	version = strings.TrimPrefix(strings.TrimSpace(version), "v")
+	parts := strings.SplitN(version, ".", 3)
+	if len(parts) < 2 {
+		return [2]int{}, false
+	}
+	var out [2]int
+	for i := 0; i < 2; i++ {
+		n := 0
+		for _, r := range parts[i] {
+			if r < '0' || r > '9' {
+				return [2]int{}, false
+			}
+			n = n*10 + int(r-'0')
+		}
+		out[i] = n
+	}
+	return out, true
+}
+
+// removeControlPlaneTaints removes the taint(s) kubeadm applies for
+// control plane isolation, unless cfg opts the sole node out of this
+// (e.g. to keep scheduler-behavior tests realistic) via its Taints
+// field.
+func removeControlPlaneTaints(node nodes.Node, cfg *config.Cluster) error {
+	if len(cfg.Nodes) > 0 && keepsControlPlaneTaint(cfg.Nodes[0].Taints) {
+		return nil
+	}
+
+	kubeVersion, err := nodeutils.KubeVersion(node)
+	if err != nil {
+		return errors.Wrap(err, "failed to get kubernetes version for taint removal")
+	}
+
+	for _, taint := range controlPlaneIsolationTaints(kubeVersion) {
+		if err := node.Command(
+			"kubectl", "--kubeconfig=/etc/kubernetes/admin.conf",
+			"taint", "nodes", "--all", taint+"-",
+		).Run(); err != nil {
+			return errors.Wrapf(err, "failed to remove %s taint", taint)
+		}
+	}
+	return nil
+}
+
+// keepsControlPlaneTaint reports whether taints explicitly re-specifies
+// a node-role.kubernetes.io/* taint, signaling the user wants to keep
+// control plane isolation even in single-node mode.
+func keepsControlPlaneTaint(taints []config.Taint) bool {
+	for _, taint := range taints {
+		if strings.HasPrefix(taint.Key, "node-role.kubernetes.io/") {
+			return true
+		}
+	}
+	return false
+}
+
+// applyCustomTaints applies any taints configured on cfg's bootstrap
+// control plane node (cfg.Nodes[0], see SetDefaultsCluster) to node,
+// other than a node-role.kubernetes.io/* one, which only signals
+// keepsControlPlaneTaint above and is never applied here: kubeadm
+// already applies that one itself, and removeControlPlaneTaints is what
+// decides whether to take it back off.
+func applyCustomTaints(node nodes.Node, cfg *config.Cluster) error {
+	if len(cfg.Nodes) == 0 {
+		return nil
+	}
+	for _, taint := range cfg.Nodes[0].Taints {
+		if strings.HasPrefix(taint.Key, "node-role.kubernetes.io/") {
+			continue
+		}
+		spec := taintSpec(taint)
+		if err := node.Command(
+			"kubectl", "--kubeconfig=/etc/kubernetes/admin.conf",
+			"taint", "nodes", "--all", spec,
+		).Run(); err != nil {
+			return errors.Wrapf(err, "failed to apply taint %s", spec)
+		}
+	}
+	return nil
+}
+
+// taintSpec renders taint in `kubectl taint` syntax: "key[=value]:effect".
+func taintSpec(taint config.Taint) string {
+	spec := taint.Key
+	if taint.Value != "" {
+		spec += "=" + taint.Value
+	}
+	return spec + ":" + taint.Effect
+}
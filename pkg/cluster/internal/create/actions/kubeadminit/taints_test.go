@@ -0,0 +1,110 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeadminit
+
+import (
+	"testing"
+
+	"sigs.k8s.io/kind/pkg/internal/apis/config"
+)
+
+func TestMajorMinor(t *testing.T) {
+	cases := []struct {
+		version string
+		want    [2]int
+		ok      bool
+	}{
+		{"v1.24.0", [2]int{1, 24}, true},
+		{"1.24", [2]int{1, 24}, true},
+		{"v1.24.0-alpha.1", [2]int{1, 24}, true},
+		{"v1.9.3", [2]int{1, 9}, true},
+		{"v1", [2]int{}, false},
+		{"not-a-version", [2]int{}, false},
+		{"", [2]int{}, false},
+	}
+	for _, c := range cases {
+		got, ok := majorMinor(c.version)
+		if ok != c.ok || got != c.want {
+			t.Errorf("majorMinor(%q) = %v, %v; want %v, %v", c.version, got, ok, c.want, c.ok)
+		}
+	}
+}
+
+func TestKubeVersionAtLeast(t *testing.T) {
+	cases := []struct {
+		version, atLeast string
+		want             bool
+	}{
+		{"v1.24.0", "v1.24", true},
+		{"v1.24.0-alpha.1", "v1.24", true},
+		{"v1.23.5", "v1.24", false},
+		{"v1.25.0", "v1.24", true},
+		{"1.24", "v1.24", true},
+		{"malformed", "v1.24", true},
+		{"v1.24.0", "malformed", true},
+	}
+	for _, c := range cases {
+		if got := kubeVersionAtLeast(c.version, c.atLeast); got != c.want {
+			t.Errorf("kubeVersionAtLeast(%q, %q) = %v, want %v", c.version, c.atLeast, got, c.want)
+		}
+	}
+}
+
+func TestControlPlaneIsolationTaints(t *testing.T) {
+	cases := []struct {
+		version string
+		want    []string
+	}{
+		{"v1.23.5", []string{"node-role.kubernetes.io/master"}},
+		{"v1.24.0", []string{"node-role.kubernetes.io/control-plane"}},
+		{"v1.24.0-alpha.1", []string{"node-role.kubernetes.io/control-plane"}},
+		{"malformed", []string{"node-role.kubernetes.io/control-plane"}},
+	}
+	for _, c := range cases {
+		got := controlPlaneIsolationTaints(c.version)
+		if len(got) != 1 || got[0] != c.want[0] {
+			t.Errorf("controlPlaneIsolationTaints(%q) = %v, want %v", c.version, got, c.want)
+		}
+	}
+}
+
+func TestKeepsControlPlaneTaint(t *testing.T) {
+	if keepsControlPlaneTaint(nil) {
+		t.Error("keepsControlPlaneTaint(nil) = true, want false")
+	}
+	if keepsControlPlaneTaint([]config.Taint{{Key: "dedicated", Effect: "NoSchedule"}}) {
+		t.Error("unrelated taint incorrectly reported as keeping the control-plane taint")
+	}
+	if !keepsControlPlaneTaint([]config.Taint{{Key: "node-role.kubernetes.io/control-plane", Effect: "NoSchedule"}}) {
+		t.Error("node-role.kubernetes.io/* taint not reported as keeping the control-plane taint")
+	}
+}
+
+func TestTaintSpec(t *testing.T) {
+	cases := []struct {
+		taint config.Taint
+		want  string
+	}{
+		{config.Taint{Key: "dedicated", Value: "x", Effect: "NoSchedule"}, "dedicated=x:NoSchedule"},
+		{config.Taint{Key: "dedicated", Effect: "NoSchedule"}, "dedicated:NoSchedule"},
+	}
+	for _, c := range cases {
+		if got := taintSpec(c.taint); got != c.want {
+			t.Errorf("taintSpec(%+v) = %q, want %q", c.taint, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeadminit
+
+import (
+	"bytes"
+	"encoding/json"
+	"text/template"
+
+	"sigs.k8s.io/kind/pkg/cluster/nodes"
+	"sigs.k8s.io/kind/pkg/errors"
+	"sigs.k8s.io/kind/pkg/internal/apis/config"
+)
+
+// kindConfigMapName is the ConfigMap kind uploads its own effective
+// cluster configuration to, following the same pattern kubeadm uses for
+// its "kubeadm-config" ConfigMap.
+const kindConfigMapName = "kind-config"
+
+// kindConfigMapNamespace matches kubeadm-config's namespace so that both
+// are found in the same place.
+const kindConfigMapNamespace = "kube-system"
+
+var kindConfigMapTemplate = template.Must(template.New("kind-config").Parse(`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: {{ .Name }}
+  namespace: {{ .Namespace }}
+data:
+  Image: {{ .Image | printf "%q" }}
+  KubeadmAPIVersion: {{ .KubeadmAPIVersion | printf "%q" }}
+  Networking: {{ .Networking | printf "%q" }}
+  NodeRoles: {{ .NodeRoles | printf "%q" }}
+  FeatureGates: {{ .FeatureGates | printf "%q" }}
+  KubeadmConfigPatches: {{ .KubeadmConfigPatches | printf "%q" }}
+`))
+
+// kindConfigMapData is the set of values rendered into the kind-config
+// ConfigMap. Fields that aren't plain strings are JSON encoded, the
+// same way kubeadm's own kubeadm-config ConfigMap embeds its
+// ClusterConfiguration as a single YAML-in-a-string data value.
+type kindConfigMapData struct {
+	Name                 string
+	Namespace            string
+	Image                string
+	KubeadmAPIVersion    string
+	Networking           string
+	NodeRoles            string
+	FeatureGates         string
+	KubeadmConfigPatches string
+}
+
+// renderKindConfigMap renders the kind-config ConfigMap manifest for
+// cfg, recording image, networking, feature gates, kubeadm config
+// patches, per-node roles, and the kubeadm config API version used, so
+// later kind operations (adding a node, upgrading, exporting logs) have
+// a source of truth that survives the host losing its kind CLI state.
+func renderKindConfigMap(cfg *config.Cluster) ([]byte, error) {
+	data := kindConfigMapData{
+		Name:              kindConfigMapName,
+		Namespace:         kindConfigMapNamespace,
+		Image:             cfg.Nodes[0].Image,
+		KubeadmAPIVersion: config.KubeadmAPIVersion,
+	}
+
+	networking, err := json.Marshal(cfg.Networking)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal networking config")
+	}
+	data.Networking = string(networking)
+
+	var roles []string
+	for _, n := range cfg.Nodes {
+		roles = append(roles, string(n.Role))
+	}
+	nodeRoles, err := json.Marshal(roles)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal node roles")
+	}
+	data.NodeRoles = string(nodeRoles)
+
+	featureGates, err := json.Marshal(cfg.FeatureGates)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal feature gates")
+	}
+	data.FeatureGates = string(featureGates)
+
+	patches, err := json.Marshal(cfg.KubeadmConfigPatches)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal kubeadm config patches")
+	}
+	data.KubeadmConfigPatches = string(patches)
+
+	var manifest bytes.Buffer
+	if err := kindConfigMapTemplate.Execute(&manifest, data); err != nil {
+		return nil, errors.Wrap(err, "failed to render kind-config ConfigMap")
+	}
+	return manifest.Bytes(), nil
+}
+
+// uploadKindConfig uploads cfg's effective settings to a "kind-config"
+// ConfigMap in kube-system, mirroring kubeadm's own ClusterConfiguration
+// / ClusterStatus upload.
+func uploadKindConfig(node nodes.Node, cfg *config.Cluster) error {
+	manifest, err := renderKindConfigMap(cfg)
+	if err != nil {
+		return err
+	}
+
+	cmd := node.Command(
+		"kubectl", "--kubeconfig=/etc/kubernetes/admin.conf",
+		"apply", "-f", "-",
+	)
+	cmd.SetStdin(bytes.NewReader(manifest))
+	if err := cmd.Run(); err != nil {
+		return errors.Wrap(err, "failed to upload kind-config ConfigMap")
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeadminit
+
+import (
+	"strings"
+	"testing"
+
+	"sigs.k8s.io/kind/pkg/internal/apis/config"
+)
+
+func TestRenderKindConfigMap(t *testing.T) {
+	cfg := &config.Cluster{
+		Nodes: []config.Node{
+			{Role: config.ControlPlaneRole, Image: "kindest/node:v1.27.0"},
+			{Role: config.WorkerRole, Image: "kindest/node:v1.27.0"},
+		},
+		Networking: config.Networking{IPFamily: config.IPv4Family},
+		FeatureGates: map[string]bool{
+			"SomeFeature": true,
+		},
+		KubeadmConfigPatches: []string{"kind: ClusterConfiguration"},
+	}
+
+	manifest, err := renderKindConfigMap(cfg)
+	if err != nil {
+		t.Fatalf("renderKindConfigMap returned error: %v", err)
+	}
+
+	out := string(manifest)
+	for _, want := range []string{
+		"name: " + kindConfigMapName,
+		"namespace: " + kindConfigMapNamespace,
+		"kindest/node:v1.27.0",
+		config.KubeadmAPIVersion,
+		"control-plane",
+		"worker",
+		"SomeFeature",
+		"ClusterConfiguration",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("rendered ConfigMap missing %q, got:\n%s", want, out)
+		}
+	}
+}
+
+func TestRenderKindConfigMapEmptyCluster(t *testing.T) {
+	cfg := &config.Cluster{
+		Nodes: []config.Node{{Role: config.ControlPlaneRole}},
+	}
+	if _, err := renderKindConfigMap(cfg); err != nil {
+		t.Fatalf("renderKindConfigMap returned error for a minimal cluster: %v", err)
+	}
+}
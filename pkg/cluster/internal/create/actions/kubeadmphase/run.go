@@ -0,0 +1,57 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package kubeadmphase holds a small helper shared by the kubeadm init
+// phase actions (certs, kubeconfig, kubelet-start, etcd, control-plane,
+// upload-config, mark-control-plane, bootstrap-token, addon), which
+// each run one `kubeadm init phase ...` instead of the old monolithic
+// `kubeadm init`.
+package kubeadmphase
+
+import (
+	"strings"
+
+	"sigs.k8s.io/kind/pkg/cluster/nodes"
+	"sigs.k8s.io/kind/pkg/errors"
+	"sigs.k8s.io/kind/pkg/exec"
+	"sigs.k8s.io/kind/pkg/log"
+)
+
+// Run runs `kubeadm init phase <phase> <args...> --config=/kind/kubeadm.conf`
+// on node, logging combined output at verbosity 3 the same way the
+// original monolithic kubeadm init call did.
+func Run(node nodes.Node, logger log.Logger, phase string, args ...string) error {
+	cmdArgs := append([]string{"init", "phase", phase}, args...)
+	cmdArgs = append(cmdArgs, "--config=/kind/kubeadm.conf", "--v=6")
+	cmd := node.Command("kubeadm", cmdArgs...)
+	lines, err := exec.CombinedOutputLines(cmd)
+	logger.V(3).Info(strings.Join(lines, "\n"))
+	if err != nil {
+		return errors.Wrapf(err, "failed to run kubeadm init phase %s", phase)
+	}
+	return nil
+}
+
+// Skipped returns true if phase (or phase/subPhase, e.g.
+// "addon/coredns") appears in skipPhases.
+func Skipped(skipPhases []string, phase string) bool {
+	for _, skipped := range skipPhases {
+		if skipped == phase {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package kubeletstart implements the `kubeadm init phase
+// kubelet-start` action, split out of the old monolithic kubeadm init
+// action. It must run after kubeconfig and before control-plane/etcd:
+// it is what writes /var/lib/kubelet/config.yaml and
+// kubeadm-flags.env and (re)starts the kubelet, and the control-plane
+// and etcd phases only write static pod manifests for a kubelet that
+// is already running to pick up.
+package kubeletstart
+
+import (
+	"sigs.k8s.io/kind/pkg/cluster/internal/create/actions"
+	"sigs.k8s.io/kind/pkg/cluster/internal/create/actions/kubeadmphase"
+	"sigs.k8s.io/kind/pkg/cluster/nodeutils"
+)
+
+type action struct{}
+
+// NewAction returns a new action for running `kubeadm init phase kubelet-start`
+func NewAction() actions.Action {
+	return &action{}
+}
+
+// Execute runs the action
+func (a *action) Execute(ctx *actions.ActionContext) error {
+	ctx.Status.Start("Starting kubelet 🚀")
+	defer ctx.Status.End(false)
+
+	allNodes, err := ctx.Nodes()
+	if err != nil {
+		return err
+	}
+	node, err := nodeutils.BootstrapControlPlaneNode(allNodes)
+	if err != nil {
+		return err
+	}
+
+	if kubeadmphase.Skipped(ctx.Config.SkipPhases, "kubelet-start") {
+		ctx.Status.End(true)
+		return nil
+	}
+
+	if err := kubeadmphase.Run(node, ctx.Logger, "kubelet-start"); err != nil {
+		return err
+	}
+
+	ctx.Status.End(true)
+	return nil
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cluster implements the `create cluster` command
+package cluster
+
+import (
+	"github.com/spf13/cobra"
+
+	"sigs.k8s.io/kind/pkg/apis/config/v1alpha4"
+	"sigs.k8s.io/kind/pkg/cluster"
+	"sigs.k8s.io/kind/pkg/cmd"
+	"sigs.k8s.io/kind/pkg/log"
+)
+
+type flagpole struct {
+	Name       string
+	SkipPhases []string
+}
+
+// NewCommand returns a new cobra.Command for create cluster
+func NewCommand(logger log.Logger, streams cmd.IOStreams) *cobra.Command {
+	flags := &flagpole{}
+	cmd := &cobra.Command{
+		Args:  cobra.NoArgs,
+		Use:   "cluster",
+		Short: "Creates a local Kubernetes cluster",
+		Long:  "Creates a local Kubernetes cluster using Docker container 'nodes'",
+		RunE: func(command *cobra.Command, args []string) error {
+			return runE(logger, streams, flags)
+		},
+	}
+	cmd.Flags().StringVar(&flags.Name, "name", cluster.DefaultName, "the cluster name")
+	cmd.Flags().StringArrayVar(
+		&flags.SkipPhases, "skip-phase", nil,
+		"a kubeadm init phase to skip, e.g. \"addon/coredns\" (can be used multiple times)",
+	)
+	return cmd
+}
+
+func runE(logger log.Logger, streams cmd.IOStreams, flags *flagpole) error {
+	cfg := &v1alpha4.Cluster{
+		SkipPhases: flags.SkipPhases,
+	}
+	v1alpha4.SetDefaultsCluster(cfg)
+
+	provider := cluster.NewProvider(
+		cluster.ProviderWithLogger(logger),
+	)
+	return provider.Create(
+		flags.Name,
+		cluster.CreateWithV1Alpha4Config(cfg),
+	)
+}
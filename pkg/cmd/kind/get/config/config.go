@@ -0,0 +1,81 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package config implements the `get config` command
+package config
+
+import (
+	"github.com/spf13/cobra"
+
+	"sigs.k8s.io/kind/pkg/cluster"
+	"sigs.k8s.io/kind/pkg/cluster/nodeutils"
+	"sigs.k8s.io/kind/pkg/cmd"
+	"sigs.k8s.io/kind/pkg/errors"
+	"sigs.k8s.io/kind/pkg/log"
+)
+
+type flagpole struct {
+	Name string
+}
+
+// NewCommand returns a new cobra.Command for get config
+func NewCommand(logger log.Logger, streams cmd.IOStreams) *cobra.Command {
+	flags := &flagpole{}
+	cmd := &cobra.Command{
+		Args:  cobra.NoArgs,
+		Use:   "config",
+		Short: "Prints the kind-config ConfigMap for a cluster",
+		Long: "Prints the \"kind-config\" ConfigMap kind uploads to kube-system after init, " +
+			"which records the effective cluster configuration used to create it. " +
+			"The ConfigMap lives in the cluster, so it can still be read after the host's own kind CLI state is lost. " +
+			"No other kind command (adding a node, upgrading, exporting logs) reads it; this command only prints it.",
+		RunE: func(command *cobra.Command, args []string) error {
+			return runE(logger, streams, flags)
+		},
+	}
+	cmd.Flags().StringVar(&flags.Name, "name", cluster.DefaultName, "the cluster name")
+	return cmd
+}
+
+func runE(logger log.Logger, streams cmd.IOStreams, flags *flagpole) error {
+	provider := cluster.NewProvider(cluster.ProviderWithLogger(logger))
+	allNodes, err := provider.ListNodes(flags.Name)
+	if err != nil {
+		return errors.Wrapf(err, "failed to list nodes for cluster %q", flags.Name)
+	}
+	// read the ConfigMap through the control plane node's own kubectl
+	// and admin.conf, the same way every other in-cluster read in this
+	// codebase works (see kubeadminit.uploadKindConfig), rather than
+	// assuming a kubectl binary and a "kind-<name>" context are set up
+	// on the host running this command
+	node, err := nodeutils.BootstrapControlPlaneNode(allNodes)
+	if err != nil {
+		return errors.Wrapf(err, "failed to find control plane node for cluster %q", flags.Name)
+	}
+
+	getCmd := node.Command(
+		"kubectl", "--kubeconfig=/etc/kubernetes/admin.conf",
+		"--namespace", "kube-system",
+		"get", "configmap", "kind-config",
+		"-o", "yaml",
+	)
+	getCmd.SetStdout(streams.Out)
+	getCmd.SetStderr(streams.ErrOut)
+	if err := getCmd.Run(); err != nil {
+		return errors.Wrapf(err, "failed to get kind-config for cluster %q", flags.Name)
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+// Etcd contains elements describing the cluster's etcd configuration.
+//
+// Exactly one of Local or External should be set; Local (the stacked,
+// kind-managed etcd member on each control plane node) is assumed when
+// neither is set, matching kubeadm's own default.
+type Etcd struct {
+	// Local, if set, configures the default stacked etcd provisioned by
+	// kubeadm on each control plane node.
+	Local *LocalEtcd `json:"local,omitempty"`
+	// External, if set, configures kind to join an etcd cluster that is
+	// provisioned and managed outside of the kind nodes.
+	External *ExternalEtcd `json:"external,omitempty"`
+}
+
+// LocalEtcd describes the stacked etcd member kubeadm manages on a
+// control plane node. It exists so that in the future we can expose
+// tuning (e.g. extra args, data dir) without another API bump.
+type LocalEtcd struct{}
+
+// ExternalEtcd describes how to reach an etcd cluster that kind does not
+// provision, mirroring kubeadm's own ClusterConfiguration.Etcd.External.
+type ExternalEtcd struct {
+	// Endpoints of etcd members, in the form "https://host:port".
+	Endpoints []string `json:"endpoints"`
+	// CAFile is the path, on the boot node, to the etcd CA certificate
+	// used to verify the endpoints above.
+	CAFile string `json:"caFile,omitempty"`
+	// CertFile is the path, on the boot node, to a client certificate
+	// signed by CAFile that kubeadm / the API server can use to
+	// authenticate to etcd.
+	CertFile string `json:"certFile,omitempty"`
+	// KeyFile is the path, on the boot node, to the private key matching
+	// CertFile.
+	KeyFile string `json:"keyFile,omitempty"`
+}
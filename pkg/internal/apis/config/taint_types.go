@@ -0,0 +1,29 @@
+/*
+Copyright 2022 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+// Taint represents a Kubernetes taint, matching corev1.Taint's shape so
+// it round-trips through `kubectl taint` / the node object unchanged.
+type Taint struct {
+	// Key is the taint key.
+	Key string
+	// Value is the taint value.
+	Value string
+	// Effect is the taint effect, e.g. NoSchedule, PreferNoSchedule, or
+	// NoExecute.
+	Effect string
+}
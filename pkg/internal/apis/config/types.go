@@ -0,0 +1,97 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package config is the internal, defaulted representation of the
+// cluster config API (pkg/apis/config/v1alpha4). Actions consume this
+// type, never the public v1alpha4 one directly.
+package config
+
+// Cluster contains kind cluster configuration, after conversion from
+// v1alpha4.Cluster and defaulting.
+type Cluster struct {
+	// Nodes contains the list of nodes defined in the kind cluster.
+	Nodes []Node
+	// Networking contains cluster wide network settings.
+	Networking Networking
+	// Etcd configures how the cluster's etcd is provisioned.
+	Etcd Etcd
+	// FeatureGates contains the feature gates to enable on the cluster,
+	// passed through to kubeadm's ClusterConfiguration.
+	FeatureGates map[string]bool
+	// KubeadmConfigPatches are applied to the generated kubeadm config,
+	// as strategic merge patches, before it is used.
+	KubeadmConfigPatches []string
+	// SkipPhases lists kubeadm init phase actions to skip, populated
+	// from `create cluster --skip-phase=<phase>[/<sub-phase>]`
+	// (repeatable). Each entry names a phase, e.g. "certs", or a phase
+	// and sub-phase, e.g. "addon/coredns"; see kubeadmphase.Skipped.
+	SkipPhases []string
+}
+
+// KubeadmAPIVersion is the kubeadm config API version kind renders its
+// generated /kind/kubeadm.conf against.
+const KubeadmAPIVersion = "kubeadm.k8s.io/v1beta3"
+
+// Node contains settings for a node in the `kind` Cluster.
+type Node struct {
+	// Role defines the role of the node in the Kubernetes cluster.
+	Role NodeRole
+	// Image is the node image to use when creating this node.
+	Image string
+	// Taints specifies the taints to apply to this node. Currently only
+	// honored for the bootstrap control plane node; see
+	// kubeadminit.applyCustomTaints.
+	Taints []Taint
+}
+
+// NodeRole defines possible role for nodes in a Kubernetes cluster managed by `kind`.
+type NodeRole string
+
+const (
+	// ControlPlaneRole identifies a node that hosts a Kubernetes control-plane.
+	ControlPlaneRole NodeRole = "control-plane"
+	// WorkerRole identifies a node that hosts a Kubernetes worker.
+	WorkerRole NodeRole = "worker"
+)
+
+// Networking contains cluster wide network settings.
+type Networking struct {
+	// IPFamily is the network IP family used by the cluster.
+	IPFamily ClusterIPFamily
+	// CNI identifies which CNI provider kind should install after init.
+	CNI CNI
+	// CNIManifests lists the manifest URLs and/or local file paths kind
+	// applies when CNI is "custom". Ignored otherwise.
+	CNIManifests []string
+}
+
+// CNI identifies which CNI provider kind should install after init.
+//
+// Valid values are "kindnet" (the default), "calico", "cilium",
+// "flannel", "custom", and "none".
+type CNI string
+
+// ClusterIPFamily defines cluster network IP family.
+type ClusterIPFamily string
+
+const (
+	// IPv4Family indicates an IPv4-only cluster network.
+	IPv4Family ClusterIPFamily = "ipv4"
+	// IPv6Family indicates an IPv6-only cluster network.
+	IPv6Family ClusterIPFamily = "ipv6"
+	// DualStackFamily indicates a dual-stack cluster network.
+	DualStackFamily ClusterIPFamily = "dual"
+)